@@ -3,16 +3,24 @@ package client
 import (
 	"context"
 	"fmt"
+	"sort"
+	"time"
+
 	"github.com/prometheus/client_golang/api"
 	v1 "github.com/prometheus/client_golang/api/prometheus/v1"
 	"github.com/prometheus/common/model"
 	"github.com/rs/zerolog/log"
-	"time"
 )
 
 const (
 	QueryMemoryUsage          = `100 * (1 - ((avg_over_time(node_memory_MemFree_bytes[%s]) + avg_over_time(node_memory_Cached_bytes[%s]) + avg_over_time(node_memory_Buffers_bytes[%s])) / avg_over_time(node_memory_MemTotal_bytes[%s])))`
 	QueryAllCPUBusyPercentage = `100 - (avg by (instance) (irate(node_cpu_seconds_total{mode="idle"}[%s])) * 100)`
+
+	QueryDiskIO          = `irate(node_disk_io_time_seconds_total{namespace="%s"}[2m])`
+	QueryNetworkReceive  = `irate(node_network_receive_bytes_total{namespace="%s"}[2m])`
+	QueryNetworkTransmit = `irate(node_network_transmit_bytes_total{namespace="%s"}[2m])`
+	QueryContainerCPU    = `irate(container_cpu_usage_seconds_total{namespace="%s"}[2m])`
+	QueryContainerMemory = `container_memory_working_set_bytes{namespace="%s"}`
 )
 
 type ResourcesSummary struct {
@@ -90,4 +98,128 @@ func (p *Prometheus) ResourcesSummary() (float64, float64, error) {
 		return 0, 0, err
 	}
 	return cpu, mem, nil
-}
\ No newline at end of file
+}
+
+// MetricSeries is a single Prometheus time series returned by a range query, labeled by instance/container,
+// along with p50/p95/max computed over the queried window.
+type MetricSeries struct {
+	Labels  model.Metric
+	Samples []model.SamplePair
+	P50     float64
+	P95     float64
+	Max     float64
+}
+
+// ResourceProfile is a snapshot of host and container resource usage over a test's lifetime, built from a
+// series of Prometheus range queries. It's meant to be embedded in test reports so reviewers can see CPU,
+// memory, disk I/O, and network usage without having to reconstruct the Grafana query themselves.
+type ResourceProfile struct {
+	CPU             []MetricSeries
+	Memory          []MetricSeries
+	DiskIO          []MetricSeries
+	NetworkReceive  []MetricSeries
+	NetworkTransmit []MetricSeries
+	ContainerCPU    []MetricSeries
+	ContainerMemory []MetricSeries
+}
+
+// ResourceProfile runs range queries for host CPU/memory/disk/network usage and per-container cgroup usage
+// over [start, end] at the given step, and returns the resulting series with p50/p95/max
+// already computed. The disk, network and container queries are filtered by the namespace label; the host CPU
+// and memory queries are not. It's intended to cover a single test's lifetime, e.g. from t.Start() to time.Now().
+func (p *Prometheus) ResourceProfile(namespace string, start, end time.Time, step time.Duration) (*ResourceProfile, error) {
+	queryRange := func(q string) ([]MetricSeries, error) {
+		r := v1.Range{Start: start, End: end, Step: step}
+		val, warns, err := p.API.QueryRange(context.Background(), q, r)
+		if err != nil {
+			return nil, fmt.Errorf("error running range query '%s': %w", q, err)
+		}
+		p.printWarns(warns)
+		matrix, ok := val.(model.Matrix)
+		if !ok {
+			return nil, fmt.Errorf("range query '%s' did not return a matrix", q)
+		}
+		series := make([]MetricSeries, 0, len(matrix))
+		for _, stream := range matrix {
+			series = append(series, newMetricSeries(stream))
+		}
+		return series, nil
+	}
+
+	cpu, err := queryRange(fmt.Sprintf(QueryAllCPUBusyPercentage, "2m"))
+	if err != nil {
+		return nil, err
+	}
+	mem, err := queryRange(fmt.Sprintf(QueryMemoryUsage, "2m", "2m", "2m", "2m"))
+	if err != nil {
+		return nil, err
+	}
+	diskIO, err := queryRange(fmt.Sprintf(QueryDiskIO, namespace))
+	if err != nil {
+		return nil, err
+	}
+	netRecv, err := queryRange(fmt.Sprintf(QueryNetworkReceive, namespace))
+	if err != nil {
+		return nil, err
+	}
+	netTransmit, err := queryRange(fmt.Sprintf(QueryNetworkTransmit, namespace))
+	if err != nil {
+		return nil, err
+	}
+	containerCPU, err := queryRange(fmt.Sprintf(QueryContainerCPU, namespace))
+	if err != nil {
+		return nil, err
+	}
+	containerMem, err := queryRange(fmt.Sprintf(QueryContainerMemory, namespace))
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResourceProfile{
+		CPU:             cpu,
+		Memory:          mem,
+		DiskIO:          diskIO,
+		NetworkReceive:  netRecv,
+		NetworkTransmit: netTransmit,
+		ContainerCPU:    containerCPU,
+		ContainerMemory: containerMem,
+	}, nil
+}
+
+// newMetricSeries converts a single Prometheus SampleStream into a MetricSeries, computing p50/p95/max over
+// its samples.
+func newMetricSeries(stream *model.SampleStream) MetricSeries {
+	values := make([]float64, len(stream.Values))
+	for i, sample := range stream.Values {
+		values[i] = float64(sample.Value)
+	}
+	sort.Float64s(values)
+
+	return MetricSeries{
+		Labels:  stream.Metric,
+		Samples: stream.Values,
+		P50:     percentile(values, 0.50),
+		P95:     percentile(values, 0.95),
+		Max:     maxFloat64(values),
+	}
+}
+
+// percentile returns the value at the given percentile (0-1) of a pre-sorted slice, picking the element at
+// index p*(len-1) rounded to the nearest integer (no interpolation).
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := int(p*float64(len(sorted)-1) + 0.5)
+	return sorted[idx]
+}
+
+func maxFloat64(values []float64) float64 {
+	var m float64
+	for _, v := range values {
+		if v > m {
+			m = v
+		}
+	}
+	return m
+}
@@ -0,0 +1,99 @@
+package testreporters
+
+import (
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// dedupKey identifies an allowed-message hit that should be collapsed with other hits of the same
+// message/level pair.
+type dedupKey struct {
+	message string
+	level   string
+}
+
+type dedupEntry struct {
+	reason string
+	count  int
+	first  time.Time
+	last   time.Time
+}
+
+// DedupLogger wraps a zerolog.Logger and collapses repeated allowed-message hits within window into a single
+// summary line carrying a running count and the first/last time it was seen, instead of emitting one Warn per
+// hit. Without it, a test that trips the same allowed log message 10k times prints 10k near-identical warnings.
+type DedupLogger struct {
+	underlying zerolog.Logger
+	window     time.Duration
+
+	mu      sync.Mutex
+	entries map[dedupKey]*dedupEntry
+}
+
+// NewDedupLogger wraps underlying so that allowed-message hits recorded via WarnAllowed are deduped within
+// window: the first hit of a given message/level logs immediately, subsequent hits are counted silently and
+// flushed as one summary line by the first hit that arrives once window has elapsed since the first hit (or
+// when Flush is called). There is no timer: a pair that gets no further hits waits for Flush.
+func NewDedupLogger(underlying zerolog.Logger, window time.Duration) *DedupLogger {
+	return &DedupLogger{
+		underlying: underlying,
+		window:     window,
+		entries:    map[dedupKey]*dedupEntry{},
+	}
+}
+
+// WarnAllowed records one hit of an allowed log message.
+func (d *DedupLogger) WarnAllowed(message, reason, level string) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	key := dedupKey{message: message, level: level}
+	now := time.Now()
+
+	entry, ok := d.entries[key]
+	if !ok {
+		d.entries[key] = &dedupEntry{reason: reason, count: 1, first: now, last: now}
+		d.underlying.Warn().
+			Str("Reason", reason).
+			Str("Level", level).
+			Str("Msg", message).
+			Msg("Found allowed log message, ignoring")
+		return
+	}
+
+	entry.count++
+	entry.last = now
+	if now.Sub(entry.first) >= d.window {
+		d.flushEntry(key, entry)
+		delete(d.entries, key)
+	}
+}
+
+func (d *DedupLogger) flushEntry(key dedupKey, entry *dedupEntry) {
+	if entry.count <= 1 {
+		return
+	}
+	d.underlying.Warn().
+		Str("Reason", entry.reason).
+		Str("Level", key.level).
+		Str("Msg", key.message).
+		Int("Count", entry.count).
+		Time("First", entry.first).
+		Time("Last", entry.last).
+		Msg("Found allowed log message repeatedly, collapsing into summary")
+}
+
+// Flush emits a summary line for every message/level pair still pending (i.e. whose window hasn't elapsed
+// yet) that has had more than one hit, and clears all pending pairs; a pair hit only once was already logged
+// and gets no summary. Call this once a teardown pass has finished scanning all its log sources, so the
+// final batch of hits isn't silently dropped.
+func (d *DedupLogger) Flush() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for key, entry := range d.entries {
+		d.flushEntry(key, entry)
+		delete(d.entries, key)
+	}
+}
@@ -0,0 +1,200 @@
+package testreporters
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// LogLineParser turns a single raw log line into a level, message, and the
+// remaining structured fields, regardless of which logging library emitted it.
+// This lets VerifyLogFile compare log levels from any sidecar container whose
+// logs are either JSON (level/msg keys, as zap/slog/logrus emit) or logfmt
+// (level=/lvl=/severity= pairs) against the same failure-threshold and
+// allow-list machinery used for Chainlink node logs. Sidecars with their own
+// bespoke line format - e.g. nginx's default "[error] 123#0: ..." error log -
+// aren't recognized by either parser and are treated as unrecognized lines.
+type LogLineParser interface {
+	// Parse returns the log level and message of line, along with any other
+	// fields present. level is returned exactly as found in the line; callers
+	// that need a zapcore.Level should normalize it with parseZapLevel.
+	Parse(line []byte) (level string, msg string, fields map[string]any, err error)
+}
+
+// ErrNoLevelField is returned by the JSON parser when a line is valid JSON but carries no "level" field.
+// LogfmtParser does not return it: a logfmt line without a level=, lvl= or severity= field gets a plain error
+// and is treated as an unrecognized line. VerifyLogStream treats ErrNoLevelField
+// as a hard failure rather than skipping the line, the same way the original zap-only scanner always did for
+// a malformed Chainlink log line.
+var ErrNoLevelField = errors.New("no recognized log level field found")
+
+// jsonLevelMsgParser parses JSON log lines keyed by "level"/"msg", which covers Chainlink core's zap output,
+// Go stdlib log/slog's JSON handler, and logrus's JSON formatter alike - all three use the same key names, so
+// one implementation serves all of them.
+type jsonLevelMsgParser struct{}
+
+func (jsonLevelMsgParser) Parse(line []byte) (string, string, map[string]any, error) {
+	fields := map[string]any{}
+	if err := json.Unmarshal(line, &fields); err != nil {
+		return "", "", nil, err
+	}
+	return parseJSONFields(fields, line)
+}
+
+func parseJSONFields(fields map[string]any, line []byte) (string, string, map[string]any, error) {
+	level, ok := fields["level"].(string)
+	if !ok {
+		return "", "", fields, fmt.Errorf("%w in line: %s", ErrNoLevelField, line)
+	}
+	msg, _ := fields["msg"].(string)
+	return level, msg, fields, nil
+}
+
+// LogfmtParser parses key=value logfmt lines, e.g. level=info msg="hello" component=api, as emitted by many Go
+// services that don't use JSON logging (note: this is not nginx's own log format - nginx would need to be
+// configured with a custom logfmt-style log_format to match). It also accepts GCP/Stackdriver-style severity=
+// lines, falling back to level, then lvl, then severity.
+type LogfmtParser struct{}
+
+func (LogfmtParser) Parse(line []byte) (string, string, map[string]any, error) {
+	fields := map[string]any{}
+	for _, pair := range splitLogfmtPairs(string(line)) {
+		key, value, found := strings.Cut(pair, "=")
+		if !found {
+			continue
+		}
+		fields[key] = strings.Trim(value, `"`)
+	}
+	level, ok := fields["level"].(string)
+	if !ok {
+		level, ok = fields["lvl"].(string)
+	}
+	if !ok {
+		level, ok = fields["severity"].(string)
+	}
+	if !ok {
+		return "", "", nil, fmt.Errorf("found no level=, lvl=, or severity= field in logfmt line: %s", line)
+	}
+	msg, _ := fields["msg"].(string)
+	return level, msg, fields, nil
+}
+
+// splitLogfmtPairs splits a logfmt line into key=value tokens, keeping
+// double-quoted values (which may contain spaces) intact.
+func splitLogfmtPairs(line string) []string {
+	var pairs []string
+	var current strings.Builder
+	inQuotes := false
+	for _, r := range line {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+			current.WriteRune(r)
+		case r == ' ' && !inQuotes:
+			if current.Len() > 0 {
+				pairs = append(pairs, current.String())
+				current.Reset()
+			}
+		default:
+			current.WriteRune(r)
+		}
+	}
+	if current.Len() > 0 {
+		pairs = append(pairs, current.String())
+	}
+	return pairs
+}
+
+// ParserFormat names a supported log line format, used as the registry key.
+type ParserFormat string
+
+const (
+	ParserFormatZapJSON    ParserFormat = "zap-json"
+	ParserFormatSlogJSON   ParserFormat = "slog-json"
+	ParserFormatLogrusJSON ParserFormat = "logrus-json"
+	ParserFormatLogfmt     ParserFormat = "logfmt"
+)
+
+// parserRegistry holds one LogLineParser per supported format, keyed by ParserFormat so callers that already
+// know a file's format can skip autodetection via DetectParser. zap/slog/logrus all share jsonLevelMsgParser
+// since their JSON output uses the same level/msg keys.
+var parserRegistry = map[ParserFormat]LogLineParser{
+	ParserFormatZapJSON:    jsonLevelMsgParser{},
+	ParserFormatSlogJSON:   jsonLevelMsgParser{},
+	ParserFormatLogrusJSON: jsonLevelMsgParser{},
+	ParserFormatLogfmt:     LogfmtParser{},
+}
+
+// ParserForFormat returns the registered LogLineParser for format, or nil if
+// format isn't registered.
+func ParserForFormat(format ParserFormat) LogLineParser {
+	return parserRegistry[format]
+}
+
+// DetectParser inspects a single log line and returns the LogLineParser best suited to parse it, based on its
+// first non-whitespace byte: '{' selects the JSON parser, level=/lvl=/severity= tokens select LogfmtParser,
+// and anything else falls back to the JSON parser (the historical default), which simply fails to parse it.
+//
+// Prefer parseLine over DetectParser+Parse on a hot per-line path: DetectParser only returns a parser, so
+// following it with Parse decodes JSON lines twice.
+func DetectParser(line []byte) LogLineParser {
+	trimmed := bytes.TrimSpace(line)
+	if len(trimmed) > 0 && trimmed[0] == '{' {
+		return jsonLevelMsgParser{}
+	}
+	if bytes.Contains(trimmed, []byte("level=")) || bytes.Contains(trimmed, []byte("lvl=")) || bytes.Contains(trimmed, []byte("severity=")) {
+		return LogfmtParser{}
+	}
+	return jsonLevelMsgParser{}
+}
+
+// parseLine parses line with parser if given, otherwise auto-detects the format and parses it in a single
+// pass - unlike DetectParser(line).Parse(line), this never decodes a JSON line twice.
+func parseLine(line []byte, parser LogLineParser) (level string, msg string, fields map[string]any, err error) {
+	if parser != nil {
+		return parser.Parse(line)
+	}
+
+	trimmed := bytes.TrimSpace(line)
+	if len(trimmed) == 0 {
+		return "", "", nil, fmt.Errorf("empty line")
+	}
+
+	if trimmed[0] == '{' {
+		fields = map[string]any{}
+		if err := json.Unmarshal(trimmed, &fields); err != nil {
+			return "", "", nil, err
+		}
+		return parseJSONFields(fields, trimmed)
+	}
+
+	if bytes.Contains(trimmed, []byte("level=")) || bytes.Contains(trimmed, []byte("lvl=")) || bytes.Contains(trimmed, []byte("severity=")) {
+		return LogfmtParser{}.Parse(trimmed)
+	}
+
+	return "", "", nil, fmt.Errorf("unrecognized log line format: %s", trimmed)
+}
+
+// parseZapLevel normalizes a level string from any supported LogLineParser into a zapcore.Level, so
+// VerifyLogFile can compare every format against the same failingLogLevel regardless of which parser produced
+// it. This also covers GCP/Stackdriver-style severity values, which don't map 1:1 onto zapcore's names.
+func parseZapLevel(level string) (zapcore.Level, error) {
+	normalized := strings.ToLower(level)
+	switch normalized {
+	case "crit", "critical":
+		return zapcore.DPanicLevel, nil
+	case "alert", "emergency":
+		return zapcore.FatalLevel, nil
+	case "warning":
+		normalized = "warn"
+	case "notice":
+		normalized = "info"
+	case "default":
+		normalized = "debug"
+	}
+	return zapcore.ParseLevel(normalized)
+}
@@ -0,0 +1,44 @@
+package testreporters
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+func TestLogfmtParser_SeverityFallback(t *testing.T) {
+	level, msg, _, err := LogfmtParser{}.Parse([]byte(`severity=error msg="boom"`))
+	require.NoError(t, err)
+	assert.Equal(t, "error", level)
+	assert.Equal(t, "boom", msg)
+}
+
+func TestParseZapLevel_Severity(t *testing.T) {
+	cases := map[string]zapcore.Level{
+		"DEFAULT":   zapcore.DebugLevel,
+		"INFO":      zapcore.InfoLevel,
+		"NOTICE":    zapcore.InfoLevel,
+		"WARNING":   zapcore.WarnLevel,
+		"ERROR":     zapcore.ErrorLevel,
+		"CRITICAL":  zapcore.DPanicLevel,
+		"ALERT":     zapcore.FatalLevel,
+		"EMERGENCY": zapcore.FatalLevel,
+	}
+	for severity, want := range cases {
+		got, err := parseZapLevel(severity)
+		require.NoError(t, err, "severity %s", severity)
+		assert.Equal(t, want, got, "severity %s", severity)
+	}
+}
+
+// TestVerifyLogStream_SeverityTripsThreshold proves a GCP/Stackdriver-style severity= sidecar log line is
+// compared against failingLogLevel, not silently skipped the way an unrecognized format would be.
+func TestVerifyLogStream_SeverityTripsThreshold(t *testing.T) {
+	stream := strings.NewReader(`severity=error msg="boom"` + "\n")
+	err := VerifyLogStream(stream, "sidecar.log", nil, nil, nil, zapcore.ErrorLevel, 1)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "sidecar.log")
+}
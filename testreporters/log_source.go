@@ -0,0 +1,156 @@
+package testreporters
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+)
+
+// NamedLogStream pairs a readable log stream with a human-readable name, used in error messages and reports
+// to identify which file/stream a finding came from.
+type NamedLogStream struct {
+	Name   string
+	Reader io.ReadCloser
+}
+
+// LogSource acquires one or more log streams for a teardown verification pass. FilesystemLogSource covers
+// the historical behavior of walking pod log directories dumped to disk; CloudWatchLogSource covers nodes
+// that ship their logs off-cluster to AWS CloudWatch Logs instead of stdout.
+type LogSource interface {
+	// Streams returns one NamedLogStream per log file/stream this source finds. Callers are responsible for
+	// closing each Reader.
+	Streams(ctx context.Context) ([]NamedLogStream, error)
+}
+
+// FilesystemLogSource finds log files already on disk (e.g. pulled from pods via env.Artifacts.DumpTestResult)
+// whose path contains at least one of PartialFilenames. This is FindAllLogFilesToScan wrapped as a LogSource.
+type FilesystemLogSource struct {
+	DirectoryPath    string
+	PartialFilenames []string
+}
+
+func NewFilesystemLogSource(directoryPath string, partialFilenames ...string) FilesystemLogSource {
+	return FilesystemLogSource{DirectoryPath: directoryPath, PartialFilenames: partialFilenames}
+}
+
+func (s FilesystemLogSource) Streams(_ context.Context) ([]NamedLogStream, error) {
+	files, err := FindAllLogFilesToScan(s.DirectoryPath, s.PartialFilenames...)
+	if err != nil {
+		return nil, err
+	}
+	streams := make([]NamedLogStream, 0, len(files))
+	for _, f := range files {
+		streams = append(streams, NamedLogStream{Name: f.Name(), Reader: f})
+	}
+	return streams, nil
+}
+
+// CloudWatchConfig configures a CloudWatchLogSource.
+type CloudWatchConfig struct {
+	// LogGroupName is the CloudWatch Logs group to pull events from.
+	LogGroupName string
+	// LogStreamNamePrefix restricts the pull to log streams with this prefix (e.g. a pod name). Optional.
+	LogStreamNamePrefix string
+	// StartTime and EndTime bound the FilterLogEvents window, normally the test's lifetime.
+	StartTime, EndTime time.Time
+	// Region is the AWS region the log group lives in.
+	Region string
+	// EndpointOverride points the CloudWatch Logs client at a non-AWS endpoint, e.g. localstack in CI.
+	EndpointOverride string
+	// RoleARN, if set, is assumed via STS before making any CloudWatch Logs calls.
+	RoleARN string
+}
+
+// CloudWatchLogSource pulls log events from AWS CloudWatch Logs via FilterLogEvents, so tests whose nodes ship
+// logs to CloudWatch rather than pod stdout can still be verified by VerifyLogStream.
+type CloudWatchLogSource struct {
+	Config CloudWatchConfig
+}
+
+func NewCloudWatchLogSource(cfg CloudWatchConfig) CloudWatchLogSource {
+	return CloudWatchLogSource{Config: cfg}
+}
+
+// Streams pulls every matching log event in [Config.StartTime, Config.EndTime] and groups them by CloudWatch
+// log stream name, returning one NamedLogStream per log stream with events newline-joined in chronological
+// order so VerifyLogStream can scan them line by line like any other log file.
+func (s CloudWatchLogSource) Streams(ctx context.Context) ([]NamedLogStream, error) {
+	client, err := s.newClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	eventsByStream := map[string]*strings.Builder{}
+	var streamOrder []string
+
+	input := &cloudwatchlogs.FilterLogEventsInput{
+		LogGroupName: aws.String(s.Config.LogGroupName),
+		StartTime:    aws.Int64(s.Config.StartTime.UnixMilli()),
+		EndTime:      aws.Int64(s.Config.EndTime.UnixMilli()),
+	}
+	if s.Config.LogStreamNamePrefix != "" {
+		input.LogStreamNamePrefix = aws.String(s.Config.LogStreamNamePrefix)
+	}
+
+	paginator := cloudwatchlogs.NewFilterLogEventsPaginator(client, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error filtering CloudWatch log events for group '%s': %w", s.Config.LogGroupName, err)
+		}
+		for _, event := range page.Events {
+			streamName := aws.ToString(event.LogStreamName)
+			builder, ok := eventsByStream[streamName]
+			if !ok {
+				builder = &strings.Builder{}
+				eventsByStream[streamName] = builder
+				streamOrder = append(streamOrder, streamName)
+			}
+			builder.WriteString(aws.ToString(event.Message))
+			builder.WriteString("\n")
+		}
+	}
+
+	streams := make([]NamedLogStream, 0, len(streamOrder))
+	for _, streamName := range streamOrder {
+		name := fmt.Sprintf("%s/%s", s.Config.LogGroupName, streamName)
+		streams = append(streams, NamedLogStream{
+			Name:   name,
+			Reader: io.NopCloser(strings.NewReader(eventsByStream[streamName].String())),
+		})
+	}
+	return streams, nil
+}
+
+func (s CloudWatchLogSource) newClient(ctx context.Context) (*cloudwatchlogs.Client, error) {
+	optFns := []func(*awsconfig.LoadOptions) error{}
+	if s.Config.Region != "" {
+		optFns = append(optFns, awsconfig.WithRegion(s.Config.Region))
+	}
+	cfg, err := awsconfig.LoadDefaultConfig(ctx, optFns...)
+	if err != nil {
+		return nil, fmt.Errorf("error loading AWS config for CloudWatchLogSource: %w", err)
+	}
+
+	if s.Config.RoleARN != "" {
+		cfg.Credentials = aws.NewCredentialsCache(stscreds.NewAssumeRoleProvider(sts.NewFromConfig(cfg), s.Config.RoleARN))
+	}
+
+	return cloudwatchlogs.NewFromConfig(cfg, func(o *cloudwatchlogs.Options) {
+		if s.Config.EndpointOverride != "" {
+			o.BaseEndpoint = aws.String(s.Config.EndpointOverride)
+		}
+	}), nil
+}
+
+var _ LogSource = FilesystemLogSource{}
+var _ LogSource = CloudWatchLogSource{}
@@ -2,8 +2,11 @@ package testreporters
 
 import (
 	"bufio"
-	"encoding/json"
+	"bytes"
+	"context"
+	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"os"
 	"path/filepath"
@@ -17,6 +20,7 @@ import (
 	"go.uber.org/zap/zapcore"
 	"golang.org/x/sync/errgroup"
 
+	"github.com/smartcontractkit/chainlink-testing-framework/client"
 	"github.com/smartcontractkit/chainlink-testing-framework/k8s/environment"
 )
 
@@ -32,6 +36,25 @@ type TestReporter interface {
 	SetNamespace(namespace string)
 }
 
+// ResourceProfileReporter is an optional extension of TestReporter for implementations that want their
+// report to embed a Prometheus resource profile covering the test's lifetime. WriteTeardownLogsWithProfile
+// calls SetResourceProfile before WriteReport whenever a promClient is provided and the profile query
+// succeeds; WriteTeardownLogs never does, since it passes no promClient.
+type ResourceProfileReporter interface {
+	TestReporter
+	SetResourceProfile(profile *client.ResourceProfile)
+}
+
+// SlackReportReporter is an optional extension of TestReporter for implementations that want their
+// SendSlackNotification to render via the Block Kit SlackReport builder instead of composing their own
+// message. WriteTeardownLogs builds a SlackReport from the test's pass/fail state, resource profile (if any),
+// and the log excerpts collected while verifying log sources, then calls SetSlackReport with it before
+// WriteReport.
+type SlackReportReporter interface {
+	TestReporter
+	SetSlackReport(report *SlackReport)
+}
+
 const (
 	// DefaultArtifactsDir default artifacts dir
 	DefaultArtifactsDir string = "logs"
@@ -40,31 +63,75 @@ const (
 // WriteTeardownLogs attempts to download the logs of all ephemeral test deployments onto the test runner, also writing
 // a test report if one is provided. A failing log level also enables you to fail a test based on what level logs the
 // Chainlink nodes have thrown during their test.
+//
+// extraLogSources, if any, are scanned in addition to the on-disk pod logs dumped by env.Artifacts, e.g. a
+// CloudWatchLogSource for nodes that ship logs off-cluster instead of to stdout.
+//
+// This is a thin wrapper around WriteTeardownLogsWithProfile that skips Prometheus resource profiling, kept so
+// existing callers built against this signature keep compiling. Call WriteTeardownLogsWithProfile directly to
+// also attach a resource profile to the report.
 func WriteTeardownLogs(
 	t *testing.T,
 	env *environment.Environment,
 	optionalTestReporter TestReporter,
 	failingLogLevel zapcore.Level, // Chainlink core uses zapcore for logging https://docs.chain.link/chainlink-nodes/v1/configuration#log_level
 	grafanaUrlProvider GrafanaURLProvider,
+	extraLogSources ...LogSource,
+) error {
+	return WriteTeardownLogsWithProfile(t, env, optionalTestReporter, failingLogLevel, grafanaUrlProvider, nil, time.Time{}, extraLogSources...)
+}
+
+// WriteTeardownLogsWithProfile is WriteTeardownLogs plus an optional Prometheus resource profile: when
+// promClient is non-nil, it builds a resource profile covering [testStart, now] and, if optionalTestReporter
+// implements ResourceProfileReporter, attaches it to the report. Pass a nil promClient to skip profiling; testStart
+// is then still used as the start of the SlackReport window when optionalTestReporter implements
+// SlackReportReporter.
+func WriteTeardownLogsWithProfile(
+	t *testing.T,
+	env *environment.Environment,
+	optionalTestReporter TestReporter,
+	failingLogLevel zapcore.Level,
+	grafanaUrlProvider GrafanaURLProvider,
+	promClient *client.Prometheus,
+	testStart time.Time,
+	extraLogSources ...LogSource,
 ) error {
 	logsPath := filepath.Join(DefaultArtifactsDir, fmt.Sprintf("%s-%s-%d", t.Name(), env.Cfg.Namespace, time.Now().Unix()))
 	if err := env.Artifacts.DumpTestResult(logsPath, "chainlink"); err != nil {
 		log.Warn().Err(err).Msg("Error trying to collect pod logs")
 		return err
 	}
-	logFiles, err := FindAllLogFilesToScan(logsPath, "node.log")
-	if err != nil {
-		log.Warn().Err(err).Msg("Error looking for pod logs")
-		return err
+
+	dedup := NewDedupLogger(log.Logger, time.Minute)
+	excerpts := NewLogExcerptCollector(defaultMaxLogExcerpts)
+	logSources := append([]LogSource{NewFilesystemLogSource(logsPath, "node.log")}, extraLogSources...)
+	if err := verifyLogSources(context.Background(), logSources, dedup, excerpts, failingLogLevel); err != nil {
+		assert.NoError(t, err, "Found a concerning log")
 	}
-	verifyLogsGroup := &errgroup.Group{}
-	for _, f := range logFiles {
-		file := f
-		verifyLogsGroup.Go(func() error {
-			return VerifyLogFile(file, failingLogLevel, 1)
-		})
+	dedup.Flush()
+
+	var resourceProfile *client.ResourceProfile
+	if promClient != nil {
+		profile, err := promClient.ResourceProfile(env.Cfg.Namespace, testStart, time.Now(), 15*time.Second)
+		if err != nil {
+			log.Warn().Err(err).Msg("Error building Prometheus resource profile")
+		} else {
+			resourceProfile = profile
+			if reporter, ok := optionalTestReporter.(ResourceProfileReporter); ok {
+				reporter.SetResourceProfile(profile)
+			}
+		}
+		if link, linkErr := grafanaDeepLink(grafanaUrlProvider, testStart, time.Now()); linkErr == nil {
+			log.Info().Str("url", link).Msg("Grafana dashboard covering this test's resource usage")
+		}
+	}
+
+	if reporter, ok := optionalTestReporter.(SlackReportReporter); ok {
+		report := NewSlackReport(env.Cfg.Namespace, !t.Failed(), grafanaUrlProvider, testStart, time.Now())
+		report.ResourceProfile = resourceProfile
+		report.LogExcerpts = excerpts.Excerpts()
+		reporter.SetSlackReport(report)
 	}
-	assert.NoError(t, verifyLogsGroup.Wait(), "Found a concerning log")
 
 	if t.Failed() || optionalTestReporter != nil {
 		if err := SendReport(t, env.Cfg.Namespace, logsPath, optionalTestReporter, grafanaUrlProvider); err != nil {
@@ -74,6 +141,45 @@ func WriteTeardownLogs(
 	return nil
 }
 
+// verifyLogSources opens every stream from every source and runs VerifyLogStream over each concurrently,
+// fanning out with the same errgroup pattern WriteTeardownLogs has always used for on-disk log files.
+func verifyLogSources(ctx context.Context, sources []LogSource, dedup *DedupLogger, excerpts *LogExcerptCollector, failingLogLevel zapcore.Level) error {
+	verifyLogsGroup := &errgroup.Group{}
+	for _, s := range sources {
+		source := s
+		streams, err := source.Streams(ctx)
+		if err != nil {
+			log.Warn().Err(err).Msg("Error acquiring log streams")
+			continue
+		}
+		for _, st := range streams {
+			stream := st
+			verifyLogsGroup.Go(func() error {
+				defer stream.Reader.Close()
+				return VerifyLogStream(stream.Reader, stream.Name, nil, dedup, excerpts, failingLogLevel, 1)
+			})
+		}
+	}
+	return verifyLogsGroup.Wait()
+}
+
+// grafanaDeepLink builds a Grafana dashboard URL scoped to [start, end], so a report links directly to the
+// window covering the test instead of Grafana's default time range.
+func grafanaDeepLink(provider GrafanaURLProvider, start, end time.Time) (string, error) {
+	if provider == nil {
+		return "", fmt.Errorf("no GrafanaURLProvider configured")
+	}
+	dashboardURL, err := provider.GetGrafanaDashboardURL()
+	if err != nil {
+		return "", err
+	}
+	separator := "?"
+	if strings.Contains(dashboardURL, "?") {
+		separator = "&"
+	}
+	return fmt.Sprintf("%s%sfrom=%d&to=%d", dashboardURL, separator, start.UnixMilli(), end.UnixMilli()), nil
+}
+
 // SendReport writes a test report and sends a Slack notification if the test provides one
 func SendReport(t *testing.T, namespace string, logsPath string, optionalTestReporter TestReporter, grafanaUrlProvider GrafanaURLProvider) error {
 	if optionalTestReporter != nil {
@@ -91,8 +197,10 @@ func SendReport(t *testing.T, namespace string, logsPath string, optionalTestRep
 	return nil
 }
 
-// FindAllLogFilesToScan walks through log files pulled from all pods, and gets all chainlink node logs
-func FindAllLogFilesToScan(directoryPath string, partialFilename string) (logFilesToScan []*os.File, err error) {
+// FindAllLogFilesToScan walks through log files pulled from all pods, and gets every log file whose path
+// contains at least one of partialFilenames. Passing multiple partial filenames (e.g. "node.log", "nginx.log",
+// "geth.log") lets a single teardown pass cover heterogeneous sidecar containers, not just Chainlink nodes.
+func FindAllLogFilesToScan(directoryPath string, partialFilenames ...string) (logFilesToScan []*os.File, err error) {
 	logFilePaths := []string{}
 	err = filepath.Walk(directoryPath, func(path string, info fs.FileInfo, err error) error {
 		if err != nil {
@@ -105,12 +213,15 @@ func FindAllLogFilesToScan(directoryPath string, partialFilename string) (logFil
 	})
 
 	for _, filePath := range logFilePaths {
-		if strings.Contains(filePath, partialFilename) {
-			logFileToScan, err := os.Open(filePath)
-			if err != nil {
-				return nil, err
+		for _, partialFilename := range partialFilenames {
+			if strings.Contains(filePath, partialFilename) {
+				logFileToScan, err := os.Open(filePath)
+				if err != nil {
+					return nil, err
+				}
+				logFilesToScan = append(logFilesToScan, logFileToScan)
+				break
 			}
-			logFilesToScan = append(logFilesToScan, logFileToScan)
 		}
 	}
 	return logFilesToScan, err
@@ -154,72 +265,124 @@ var defaultAllowedLogMessages = []AllowedLogMessage{
 // it will return an error. It also allows for a list of AllowedLogMessages to be passed in, which will be ignored if found
 // in the log file. The failureThreshold is the number of logs at the failingLogLevel or higher that can be found before
 // the function returns an error.
+//
+// This is a thin wrapper around VerifyLogFileWithOptions that auto-detects the line format and collects neither
+// dedup summaries nor excerpts, kept so existing callers built against this signature keep compiling. Call
+// VerifyLogFileWithOptions directly for an explicit parser, allowed-message dedup, or excerpt collection.
 func VerifyLogFile(file *os.File, failingLogLevel zapcore.Level, failureThreshold uint, allowedMessages ...AllowedLogMessage) error {
+	return VerifyLogFileWithOptions(file, nil, nil, nil, failingLogLevel, failureThreshold, allowedMessages...)
+}
+
+// VerifyLogFileWithOptions is VerifyLogFile with explicit control over line parsing and collection.
+//
+// parser controls how each line is turned into a level/msg/fields triple. Pass nil to auto-detect the format per
+// line, which lets a single call cover sidecar logs that use JSON (level/msg keys) or logfmt (level=/lvl=/
+// severity= pairs) instead of Chainlink's zap JSON format - a sidecar with its own bespoke format (e.g. nginx's
+// default error log) won't be recognized by either and its lines are treated as unrecognized. dedup, if non-nil,
+// collapses repeated allowed-message hits into a single summary line instead of one Warn per hit; pass nil to
+// log every hit immediately as before. excerpts, if non-nil, records every line that trips failingLogLevel for
+// inclusion in a SlackReport; pass nil to skip collection.
+func VerifyLogFileWithOptions(file *os.File, parser LogLineParser, dedup *DedupLogger, excerpts *LogExcerptCollector, failingLogLevel zapcore.Level, failureThreshold uint, allowedMessages ...AllowedLogMessage) error {
 	// nolint
 	defer file.Close()
+	return VerifyLogStream(file, file.Name(), parser, dedup, excerpts, failingLogLevel, failureThreshold, allowedMessages...)
+}
 
-	var (
-		zapLevel zapcore.Level
-		err      error
-	)
-	scanner := bufio.NewScanner(file)
-	scanner.Split(bufio.ScanLines)
-
+// VerifyLogStream is VerifyLogFile's underlying scanner logic, generalized to any io.Reader. It's what lets
+// LogSource implementations other than FilesystemLogSource (e.g. CloudWatchLogSource) reuse the same
+// failure-threshold and allow-list machinery without needing an *os.File. sourceName is used only in error
+// messages and the closing summary event, to identify which stream a failure came from.
+//
+// If excerpts is nil, VerifyLogStream returns as soon as failureThreshold is reached, same as before excerpt
+// collection existed. If excerpts is non-nil, it keeps scanning past the threshold so excerpts can accumulate
+// up to its maxPerSource cap, and returns the first failure only once the whole stream has been scanned.
+//
+// Regardless of outcome, VerifyLogStream emits a single structured summary event (lines_scanned,
+// above_threshold, allowed_hits, panics, duration_ms) suitable for ingestion by Loki/Elastic.
+func VerifyLogStream(r io.Reader, sourceName string, parser LogLineParser, dedup *DedupLogger, excerpts *LogExcerptCollector, failingLogLevel zapcore.Level, failureThreshold uint, allowedMessages ...AllowedLogMessage) error {
 	allAllowedMessages := append(defaultAllowedLogMessages, allowedMessages...)
+	scanner := bufio.NewScanner(r)
+	scanner.Split(bufio.ScanLines)
 
-	var logsFound uint
+	start := time.Now()
+	var linesScanned, logsFound, allowedHits, panicsFound uint
+	var lineNumber int
+	var firstFailure error
+	defer func() {
+		log.Info().
+			Str("file", sourceName).
+			Uint("lines_scanned", linesScanned).
+			Uint("above_threshold", logsFound).
+			Uint("allowed_hits", allowedHits).
+			Uint("panics", panicsFound).
+			Int64("duration_ms", time.Since(start).Milliseconds()).
+			Msg("Finished scanning log source")
+	}()
 
 SCANNER_LOOP:
 	for scanner.Scan() {
-		jsonLogLine := scanner.Text()
-		if !strings.HasPrefix(jsonLogLine, "{") { // don't bother with non-json lines
-			if strings.HasPrefix(jsonLogLine, "panic") { // unless it's a panic
-				return fmt.Errorf("found panic: %s", jsonLogLine)
-			}
+		lineNumber++
+		logLine := scanner.Bytes()
+		if len(bytes.TrimSpace(logLine)) == 0 {
 			continue
 		}
-		jsonMapping := map[string]any{}
+		linesScanned++
 
-		if err = json.Unmarshal([]byte(jsonLogLine), &jsonMapping); err != nil {
-			// This error can occur anytime someone uses %+v in a log message, ignoring
+		logLevel, logMessage, _, err := parseLine(logLine, parser)
+		if err != nil {
+			if errors.Is(err, ErrNoLevelField) {
+				// A line that parsed cleanly (valid JSON) but carries no level field is the same hard failure
+				// the original zap-only scanner always raised for a malformed Chainlink log line.
+				return fmt.Errorf("%s in %s", err, sourceName)
+			}
+			if bytes.HasPrefix(bytes.TrimSpace(logLine), []byte("panic")) { // unless it's a panic
+				panicsFound++
+				return fmt.Errorf("found panic in %s: %s", sourceName, logLine)
+			}
+			// This error can occur anytime a line doesn't match the detected format, e.g. someone using %+v in a
+			// log message, ignoring
 			continue
 		}
-		logLevel, ok := jsonMapping["level"].(string)
-		if !ok {
-			return fmt.Errorf("found no log level in chainlink log line: %s", jsonLogLine)
-		}
 
-		if logLevel == "crit" { // "crit" is a custom core type they map to DPanic
-			zapLevel = zapcore.DPanicLevel
-		} else {
-			zapLevel, err = zapcore.ParseLevel(logLevel)
-			if err != nil {
-				return fmt.Errorf("'%s' not a valid zapcore level", logLevel)
-			}
+		zapLevel, err := parseZapLevel(logLevel)
+		if err != nil {
+			return fmt.Errorf("'%s' not a valid log level in %s, line: %s", logLevel, sourceName, logLine)
 		}
 
 		if zapLevel >= failingLogLevel {
-			logErr := fmt.Errorf("found log at level '%s', failing any log level higher than %s: %s", logLevel, zapLevel.String(), jsonLogLine)
+			logErr := fmt.Errorf("found log at level '%s' in %s, failing any log level at or above %s: %s", logLevel, sourceName, failingLogLevel.String(), logLine)
 			if failureThreshold > 1 {
-				logErr = fmt.Errorf("found too many logs at level '%s' or above; failure threshold of %d reached; last error found: %s", logLevel, failureThreshold, jsonLogLine)
+				logErr = fmt.Errorf("found too many logs at level '%s' or above in %s; failure threshold of %d reached; last error found: %s", logLevel, sourceName, failureThreshold, logLine)
 			}
-			logMessage, hasMessage := jsonMapping["msg"]
-			if !hasMessage {
+			if logMessage == "" {
 				logsFound++
+				if excerpts != nil {
+					excerpts.Add(sourceName, lineNumber, string(logLine))
+				}
 				if logsFound >= failureThreshold {
-					return logErr
+					if excerpts == nil {
+						return logErr
+					}
+					if firstFailure == nil {
+						firstFailure = logErr
+					}
 				}
 				continue
 			}
 
 			for _, allowedLog := range allAllowedMessages {
-				if strings.Contains(logMessage.(string), allowedLog.message) {
+				if strings.Contains(logMessage, allowedLog.message) {
+					allowedHits++
 					if allowedLog.logWhenFound {
-						log.Warn().
-							Str("Reason", allowedLog.reason).
-							Str("Level", allowedLog.level.CapitalString()).
-							Str("Msg", logMessage.(string)).
-							Msg("Found allowed log message, ignoring")
+						if dedup != nil {
+							dedup.WarnAllowed(logMessage, allowedLog.reason, allowedLog.level.CapitalString())
+						} else {
+							log.Warn().
+								Str("Reason", allowedLog.reason).
+								Str("Level", allowedLog.level.CapitalString()).
+								Str("Msg", logMessage).
+								Msg("Found allowed log message, ignoring")
+						}
 					}
 
 					continue SCANNER_LOOP
@@ -227,10 +390,18 @@ SCANNER_LOOP:
 			}
 
 			logsFound++
+			if excerpts != nil {
+				excerpts.Add(sourceName, lineNumber, string(logLine))
+			}
 			if logsFound >= failureThreshold {
-				return logErr
+				if excerpts == nil {
+					return logErr
+				}
+				if firstFailure == nil {
+					firstFailure = logErr
+				}
 			}
 		}
 	}
-	return nil
+	return firstFailure
 }
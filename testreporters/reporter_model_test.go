@@ -0,0 +1,34 @@
+package testreporters
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+)
+
+// TestVerifyLogStream_CollectsMultipleExcerptsPastThreshold proves that once an excerpts collector is
+// supplied, VerifyLogStream keeps scanning past failureThreshold so more than one offending line can be
+// collected, instead of returning on the first hit and leaving maxPerSource/MaxLogExcerpts dead weight.
+func TestVerifyLogStream_CollectsMultipleExcerptsPastThreshold(t *testing.T) {
+	lines := strings.Repeat(`{"level":"error","msg":"boom"}`+"\n", 5)
+	stream := strings.NewReader(lines)
+	excerpts := NewLogExcerptCollector(3)
+
+	err := VerifyLogStream(stream, "node.log", nil, nil, excerpts, zapcore.ErrorLevel, 1)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "node.log")
+	assert.Len(t, excerpts.Excerpts(), 3, "should stop at MaxPerSource even though more lines tripped the threshold")
+}
+
+// TestVerifyLogStream_FailsFastWithoutExcerpts proves the pre-excerpt behavior is unchanged when no
+// collector is supplied: the scan still returns on the very first tripping line.
+func TestVerifyLogStream_FailsFastWithoutExcerpts(t *testing.T) {
+	lines := strings.Repeat(`{"level":"error","msg":"boom"}`+"\n", 5)
+	stream := strings.NewReader(lines)
+
+	err := VerifyLogStream(stream, "node.log", nil, nil, nil, zapcore.ErrorLevel, 1)
+	require.Error(t, err)
+}
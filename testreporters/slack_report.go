@@ -0,0 +1,225 @@
+package testreporters
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/slack-go/slack"
+
+	"github.com/smartcontractkit/chainlink-testing-framework/client"
+)
+
+// LogExcerpt is a single log line that tripped VerifyLogFile, kept for inclusion in a SlackReport. Text holds
+// the full line; it is truncated only when the report is rendered.
+type LogExcerpt struct {
+	File string
+	Line int
+	Text string
+}
+
+const defaultMaxLogExcerpts = 10
+const maxExcerptLineLength = 200
+
+// LogExcerptCollector gathers LogExcerpts across concurrent VerifyLogStream calls (WriteTeardownLogs scans
+// every log source's streams in parallel via an errgroup) so they can be attached to a single SlackReport.
+// maxPerSource caps how many excerpts are kept per source, so one noisy stream can't crowd out excerpts from
+// the rest.
+type LogExcerptCollector struct {
+	maxPerSource int
+
+	mu        sync.Mutex
+	excerpts  []LogExcerpt
+	perSource map[string]int
+}
+
+// NewLogExcerptCollector creates a LogExcerptCollector that keeps at most maxPerSource excerpts per source
+// name. A maxPerSource of 0 or less means unlimited.
+func NewLogExcerptCollector(maxPerSource int) *LogExcerptCollector {
+	return &LogExcerptCollector{maxPerSource: maxPerSource, perSource: map[string]int{}}
+}
+
+// Add records one excerpt from source, dropping it if source has already reached maxPerSource.
+func (c *LogExcerptCollector) Add(source string, line int, text string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.maxPerSource > 0 && c.perSource[source] >= c.maxPerSource {
+		return
+	}
+	c.perSource[source]++
+	c.excerpts = append(c.excerpts, LogExcerpt{File: source, Line: line, Text: text})
+}
+
+// Excerpts returns a copy of every excerpt collected so far.
+func (c *LogExcerptCollector) Excerpts() []LogExcerpt {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	out := make([]LogExcerpt, len(c.excerpts))
+	copy(out, c.excerpts)
+	return out
+}
+
+// slackAttachment is a file queued for upload via Attach, sent alongside the Block Kit summary message.
+type slackAttachment struct {
+	name   string
+	reader io.Reader
+}
+
+// SlackReport builds a Slack Block Kit message for a test's teardown report: a header block with pass/fail
+// and namespace, a Grafana deep link scoped to the test's window, Prometheus-derived p95 CPU/memory from a
+// ResourceProfile, and an expandable code block of truncated log excerpts. It replaces passing a bare client
+// and GrafanaURLProvider straight to SendSlackNotification.
+type SlackReport struct {
+	Namespace          string
+	Passed             bool
+	GrafanaURLProvider GrafanaURLProvider
+	Start, End         time.Time
+	ResourceProfile    *client.ResourceProfile
+	LogExcerpts        []LogExcerpt
+	MaxLogExcerpts     int
+
+	attachments []slackAttachment
+}
+
+// NewSlackReport creates a SlackReport for a test that ran in namespace over [start, end].
+func NewSlackReport(namespace string, passed bool, grafanaUrlProvider GrafanaURLProvider, start, end time.Time) *SlackReport {
+	return &SlackReport{
+		Namespace:          namespace,
+		Passed:             passed,
+		GrafanaURLProvider: grafanaUrlProvider,
+		Start:              start,
+		End:                end,
+		MaxLogExcerpts:     defaultMaxLogExcerpts,
+	}
+}
+
+// Attach queues name/rd for upload via files.upload when Send is called, alongside the Block Kit summary
+// message. Use this for full log bundles that are too large for an inline excerpt.
+func (r *SlackReport) Attach(name string, rd io.Reader) {
+	r.attachments = append(r.attachments, slackAttachment{name: name, reader: rd})
+}
+
+// Blocks renders the report as Slack Block Kit blocks, suitable for slack.MsgOptionBlocks.
+func (r *SlackReport) Blocks() []slack.Block {
+	status, emoji := "FAILED", ":x:"
+	if r.Passed {
+		status, emoji = "PASSED", ":white_check_mark:"
+	}
+
+	blocks := []slack.Block{
+		slack.NewHeaderBlock(slack.NewTextBlockObject(slack.PlainTextType,
+			fmt.Sprintf("%s Test %s: namespace %s", emoji, status, r.Namespace), false, false)),
+	}
+
+	if r.GrafanaURLProvider != nil {
+		if link, err := grafanaDeepLink(r.GrafanaURLProvider, r.Start, r.End); err == nil {
+			blocks = append(blocks, slack.NewSectionBlock(
+				slack.NewTextBlockObject(slack.MarkdownType, fmt.Sprintf("<%s|View Grafana dashboard>", link), false, false),
+				nil, nil,
+			))
+		}
+	}
+
+	if r.ResourceProfile != nil {
+		blocks = append(blocks, slack.NewContextBlock("",
+			slack.NewTextBlockObject(slack.MarkdownType, resourceProfileSummary(r.ResourceProfile), false, false)))
+	}
+
+	if excerpts := r.renderExcerpts(); excerpts != "" {
+		blocks = append(blocks, slack.NewSectionBlock(
+			slack.NewTextBlockObject(slack.MarkdownType, "```"+excerpts+"```", false, false),
+			nil, nil,
+		))
+	}
+
+	return blocks
+}
+
+// renderExcerpts formats up to MaxLogExcerpts log excerpts as "file:line: text" lines, noting how many were
+// left out if there were more.
+func (r *SlackReport) renderExcerpts() string {
+	if len(r.LogExcerpts) == 0 {
+		return ""
+	}
+	max := r.MaxLogExcerpts
+	if max <= 0 {
+		max = defaultMaxLogExcerpts
+	}
+	excerpts := r.LogExcerpts
+	truncatedCount := 0
+	if len(excerpts) > max {
+		truncatedCount = len(excerpts) - max
+		excerpts = excerpts[:max]
+	}
+
+	var b bytes.Buffer
+	for _, e := range excerpts {
+		fmt.Fprintf(&b, "%s:%d: %s\n", e.File, e.Line, truncateExcerpt(e.Text))
+	}
+	if truncatedCount > 0 {
+		fmt.Fprintf(&b, "... %d more\n", truncatedCount)
+	}
+	return b.String()
+}
+
+func truncateExcerpt(s string) string {
+	if len(s) <= maxExcerptLineLength {
+		return s
+	}
+	return s[:maxExcerptLineLength] + "..."
+}
+
+// resourceProfileSummary renders a one-line Prometheus summary of CPU/memory usage. CPU and Memory can each
+// hold multiple series (QueryAllCPUBusyPercentage groups by instance, and QueryMemoryUsage returns one
+// series per node instance), so it reports the worst (max) p95 across series rather than just the first one,
+// labeled with how many series that max was taken over.
+func resourceProfileSummary(p *client.ResourceProfile) string {
+	cpuP95, cpuSeries := maxP95(p.CPU)
+	memP95, memSeries := maxP95(p.Memory)
+	return fmt.Sprintf("CPU p95: %.1f%% (max of %d)  |  Memory p95: %.1f%% (max of %d)", cpuP95, cpuSeries, memP95, memSeries)
+}
+
+// maxP95 returns the highest P95 across series along with how many series were considered.
+func maxP95(series []client.MetricSeries) (float64, int) {
+	var max float64
+	for _, s := range series {
+		if s.P95 > max {
+			max = s.P95
+		}
+	}
+	return max, len(series)
+}
+
+// Send posts the report's blocks to channel, then uploads every attachment queued via Attach as its own
+// files.upload call.
+func (r *SlackReport) Send(slackClient *slack.Client, channel string) error {
+	if _, _, err := slackClient.PostMessage(channel, slack.MsgOptionBlocks(r.Blocks()...)); err != nil {
+		return fmt.Errorf("error posting Slack report for namespace '%s': %w", r.Namespace, err)
+	}
+	for _, a := range r.attachments {
+		if _, err := slackClient.UploadFile(slack.FileUploadParameters{
+			Channels: []string{channel},
+			Filename: a.name,
+			Reader:   a.reader,
+		}); err != nil {
+			return fmt.Errorf("error uploading attachment '%s': %w", a.name, err)
+		}
+	}
+	return nil
+}
+
+// DefaultSendSlackNotification is the fallback renderer for TestReporter implementations that haven't
+// adopted SlackReport: it builds one from the test's result and posts it to channel. Existing implementations
+// that only had a bare slack.Client and GrafanaURLProvider can call this from SendSlackNotification and keep
+// working unchanged.
+func DefaultSendSlackNotification(passed bool, namespace string, channel string, slackClient *slack.Client, grafanaUrlProvider GrafanaURLProvider, start, end time.Time, profile *client.ResourceProfile, excerpts []LogExcerpt) error {
+	if slackClient == nil || channel == "" {
+		return nil
+	}
+	report := NewSlackReport(namespace, passed, grafanaUrlProvider, start, end)
+	report.ResourceProfile = profile
+	report.LogExcerpts = excerpts
+	return report.Send(slackClient, channel)
+}